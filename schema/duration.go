@@ -1,9 +1,10 @@
 package schema
 
 import (
-	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -29,95 +30,162 @@ const (
 	minuteNanos = 1 * float64(time.Minute)            // 60000000000
 	secondNanos = 1 * float64(time.Second)            // 1000000000
 
-	seps          = "PYMWDTHMS"
-	indexOfMonths = 2
-	indexOfT      = 5
-	delimTime     = 'T'
-	unitM         = 'M' // Ambiguous Months and Minutes
-	prefix        = 'P'
+	delimTime = 'T'
+	prefix    = 'P'
 )
 
-func parseISODateDuration(dur string) ([7]float64, error) {
-	// Example: d := "P3Y6M4DT12H30M5S"
+// durationState is a position in the ISO 8601 duration grammar
+// "P(n Y)(n M)(n W)(n D)(T(n H)(n M)(n S))". W is exclusive: reachable
+// only directly from durationStart, and not followed by anything.
+type durationState int
+
+const (
+	durationStart durationState = iota
+	durationDateY
+	durationDateM
+	durationDateW
+	durationDateD
+	durationTimeMark
+	durationTimeH
+	durationTimeM
+	durationTimeS
+)
+
+// durationUnit is one legal (letter, match-index, next-state) transition
+// out of a durationState.
+type durationUnit struct {
+	letter byte
+	index  int
+	next   durationState
+}
+
+// durationTransitions encodes, for each state, which unit letters may
+// legally follow it - the field ordering and W's exclusivity live here as
+// data rather than as index arithmetic sprinkled through the parse loop.
+// H and S are also accepted straight off durationStart, without a 'T'
+// marker - the original hand-rolled parser tolerated a missing 'T' (e.g.
+// "P2H", "P22.519S"), and callers already depend on that. Bare minutes
+// is deliberately not accepted here: an 'M' straight off durationStart is
+// always months, the same ambiguity the old parser resolved by position.
+var durationTransitions = map[durationState][]durationUnit{
+	durationStart: {
+		{'Y', 0, durationDateY}, {'M', 1, durationDateM}, {'W', 2, durationDateW}, {'D', 3, durationDateD},
+		{'H', 4, durationTimeH}, {'S', 6, durationTimeS},
+	},
+	durationDateY:    {{'M', 1, durationDateM}, {'D', 3, durationDateD}},
+	durationDateM:    {{'D', 3, durationDateD}},
+	durationDateW:    {},
+	durationDateD:    {},
+	durationTimeMark: {{'H', 4, durationTimeH}, {'M', 5, durationTimeM}, {'S', 6, durationTimeS}},
+	durationTimeH:    {{'M', 5, durationTimeM}, {'S', 6, durationTimeS}},
+	durationTimeM:    {{'S', 6, durationTimeS}},
+	durationTimeS:    {},
+}
 
-	// Matches store.
+// parseISODateDuration parses an ISO 8601 duration, e.g.
+// "P3Y6M4DT12H30M5S" or "-P1Y6M", into its seven field components
+// (years, months, weeks, days, hours, minutes, seconds). It is a small
+// state machine over durationState: each token is a number followed by a
+// unit letter, and durationTransitions says which unit may legally
+// follow the current position.
+func parseISODateDuration(dur string) ([7]float64, error) {
 	m := [7]float64{}
 	if dur == "" {
 		return m, errors.New("error: empty")
 	}
+
+	// ISO 8601 permits an optional leading sign before the 'P', e.g.
+	// "-P1Y" or "+P1Y", which tableschema uses for offsets such as
+	// lag/lead. Strip it here and negate the parsed fields at the end,
+	// so the state machine below stays unaware of signedness.
+	negative := false
+	if dur[0] == '-' || dur[0] == '+' {
+		negative = dur[0] == '-'
+		dur = dur[1:]
+	}
+
 	if len(dur) <= 2 {
 		return m, errors.New("error: duration is too short to be valid")
 	}
-	// Ensure duration starts with 'P'.
-	if dur[0] != seps[0] {
+	if dur[0] != prefix {
 		return m, errors.New("error: missing 'P' prefix")
 	}
-	// Index pointers for duration and separator strings, and matches slice.
-	di := 1
-	si := 1
-	mi := 0
-	b := bytes.NewBuffer(make([]byte, 0, 64))
-	// Loop over duration, collecting number then character, repeatedly.
-	for si < len(seps) {
-		if di >= len(dur) {
-			break
+
+	state := durationStart
+	sawField := false
+	i := 1
+	for i < len(dur) {
+		if dur[i] == delimTime {
+			if state == durationTimeMark || state == durationTimeH || state == durationTimeM || state == durationTimeS {
+				return m, errors.New("error: duplicate 'T' time designator")
+			}
+			if state == durationDateW {
+				return m, errors.New("error: 'W' cannot be combined with a time part")
+			}
+			state = durationTimeMark
+			i++
+			continue
 		}
-		// Consume numeric.
-		for dur[di] >= '0' && dur[di] <= '9' {
-			b.WriteByte(dur[di])
-			di++
-		}
-		if dur[di] == '.' {
-			b.WriteByte(dur[di])
-			di++
-			if dur[di] >= '0' && dur[di] <= '9' {
-				for dur[di] >= '0' && dur[di] <= '9' {
-					b.WriteByte(dur[di])
-					di++
-				}
-			} else {
-				return m, errors.New("error: missing digit after decimal")
+
+		numStart := i
+		sawDigit, sawDot := false, false
+		for i < len(dur) {
+			switch {
+			case dur[i] >= '0' && dur[i] <= '9':
+				sawDigit = true
+			case dur[i] == '.' && !sawDot:
+				sawDot = true
+			default:
+				goto numberDone
 			}
+			i++
+		}
+	numberDone:
+		if !sawDigit {
+			return m, errors.New("error: expected a number")
+		}
+		if dur[i-1] == '.' {
+			return m, errors.New("error: missing digit after decimal point")
 		}
-		// Consume letter.
-		// Iterate over separators (si),
-		// looking for a match for the current duration character (di).
-		for si < len(seps) {
-			if dur[di] == seps[si] {
-				// If unit is T skip.
-				if dur[di] != delimTime {
-					// Distinguish between 'M' months and 'M' minutes.
-					if dur[di] == unitM && si > indexOfMonths {
-						mi = indexOfT
-					}
-					f, err := strconv.ParseFloat(b.String(), 64)
-					if err != nil {
-						return m, err
-					}
-					m[mi] = f
-				}
-				b.Reset()
-				di++
-				si++
-				if di < len(dur) && dur[di] != delimTime {
-					mi++
-				}
-				// Matches - break to store next number.
+		if i >= len(dur) {
+			return m, errors.New("error: missing unit after number")
+		}
+
+		var matched *durationUnit
+		for idx, u := range durationTransitions[state] {
+			if u.letter == dur[i] {
+				matched = &durationTransitions[state][idx]
 				break
 			}
-			// Not a match.
-			if si == len(seps)-1 {
-				return m, errors.New("error: letter not a valid unit")
-			}
-			si++
-			if seps[si] == delimTime {
-				continue
+		}
+		if matched == nil {
+			if state == durationDateW {
+				return m, errors.New("error: 'W' cannot be combined with other fields")
 			}
-			mi++
+			return m, fmt.Errorf("error: unexpected unit %q", dur[i])
+		}
+
+		f, err := strconv.ParseFloat(dur[numStart:i], 64)
+		if err != nil {
+			return m, err
 		}
+		m[matched.index] = f
+		sawField = true
+		state = matched.next
+		i++
+	}
+
+	if !sawField {
+		return m, errors.New("error: duration has no fields")
 	}
-	if di < len(dur)-1 {
-		return m, errors.New("error: invalid ISODate")
+	if state == durationTimeMark {
+		return m, errors.New("error: empty time part after 'T'")
+	}
+
+	if negative {
+		for i := range m {
+			m[i] = -m[i]
+		}
 	}
 	return m, nil
 }
@@ -128,20 +196,237 @@ func floatToDuration(i, nanos float64) time.Duration {
 	return time.Duration(n)
 }
 
+// Duration represents an ISO 8601 duration ("P3Y6M4DT12H30M5S"), keeping
+// each calendar/time field separate - rather than collapsing straight to
+// a time.Duration - so that callers can tell weeks from days, or months
+// from a 30-day approximation, and round-trip the value back to text.
+//
+// This only covers the package-level half of Duration: there is no Field
+// type in this module to hang a Field.Cast/Field.Uncast option or a
+// humanFormat option off, so callers construct a Duration directly (via
+// UnmarshalText or newDuration) rather than through per-field decode
+// options.
+type Duration struct {
+	Years   float64
+	Months  float64
+	Weeks   float64
+	Days    float64
+	Hours   float64
+	Minutes float64
+	Seconds float64
+}
+
+// newDuration builds a Duration from the field matches produced by
+// parseISODateDuration.
+func newDuration(m [7]float64) Duration {
+	return Duration{
+		Years:   m[0],
+		Months:  m[1],
+		Weeks:   m[2],
+		Days:    m[3],
+		Hours:   m[4],
+		Minutes: m[5],
+		Seconds: m[6],
+	}
+}
+
+// Total collapses d's fields into a single time.Duration, using the same
+// fixed-average approximation castDuration has always produced: years and
+// months are converted using 365.25 and 30.4375 day averages respectively.
+// It is computed from the fields on every call rather than cached, so a
+// Duration built as a struct literal (as opposed to via newDuration) can't
+// drift out of sync with its own Years/Months/.../Seconds.
+func (d Duration) Total() time.Duration {
+	return floatToDuration(d.Years, yearNanos) +
+		floatToDuration(d.Months, monthNanos) +
+		floatToDuration(d.Weeks, weekNanos) +
+		floatToDuration(d.Days, dayNanos) +
+		floatToDuration(d.Hours, hourNanos) +
+		floatToDuration(d.Minutes, minuteNanos) +
+		floatToDuration(d.Seconds, secondNanos)
+}
+
+// String formats d as a canonical ISO 8601 duration, e.g. "P1Y2M3DT4H5M6S".
+// A zero duration is formatted as "PT0S". A Duration whose fields are
+// negative (see parseISODateDuration) is formatted with a leading '-',
+// e.g. "-P1D".
+func (d Duration) String() string {
+	negative := d.Total() < 0
+	if negative {
+		d.Years, d.Months, d.Weeks, d.Days = -d.Years, -d.Months, -d.Weeks, -d.Days
+		d.Hours, d.Minutes, d.Seconds = -d.Hours, -d.Minutes, -d.Seconds
+	}
+
+	var b strings.Builder
+	b.WriteByte(prefix)
+	writeDurationField(&b, d.Years, 'Y')
+	writeDurationField(&b, d.Months, 'M')
+	writeDurationField(&b, d.Weeks, 'W')
+	writeDurationField(&b, d.Days, 'D')
+	if d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0 {
+		b.WriteByte(delimTime)
+		writeDurationField(&b, d.Hours, 'H')
+		writeDurationField(&b, d.Minutes, 'M')
+		writeDurationField(&b, d.Seconds, 'S')
+	}
+	out := b.String()
+	if out == string(prefix) {
+		out = "PT0S"
+	}
+	if negative {
+		out = "-" + out
+	}
+	return out
+}
+
+func writeDurationField(b *strings.Builder, v float64, unit byte) {
+	if v == 0 {
+		return
+	}
+	if v == float64(int64(v)) {
+		fmt.Fprintf(b, "%d%c", int64(v), unit)
+		return
+	}
+	fmt.Fprintf(b, "%s%c", strconv.FormatFloat(v, 'f', -1, 64), unit)
+}
+
+// MarshalText implements encoding.TextMarshaler, emitting the canonical
+// ISO 8601 representation of d.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the same
+// grammar parseISODateDuration understands.
+func (d *Duration) UnmarshalText(text []byte) error {
+	matches, err := parseISODateDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = newDuration(matches)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// GobEncode implements gob.GobEncoder.
+func (d Duration) GobEncode() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (d *Duration) GobDecode(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+// AddTo adds d to t. The whole years, months, weeks (as 7 days) and days
+// are applied together in a single time.Time.AddDate call, and then the
+// T-part is added. This anchors the arithmetic to the actual calendar
+// rather than to the fixed averages Total() uses. Overflow is normalized
+// the way AddDate normalizes it; it is not clamped to the end of the
+// month: adding "P1M" to Jan 31 2024 gives Mar 2 2024, not Feb 29.
+//
+// Fractional parts of calendar fields (e.g. the .5 of "P1.5M") are
+// converted with the same fixed averages as Total() and added, together
+// with the T-part, as an exact time.Duration after the AddDate call.
+func (d Duration) AddTo(t time.Time) time.Time {
+	years, fracYears := math.Modf(d.Years)
+	months, fracMonths := math.Modf(d.Months)
+	weeks, fracWeeks := math.Modf(d.Weeks)
+	days, fracDays := math.Modf(d.Days)
+
+	t = t.AddDate(int(years), int(months), int(weeks)*7+int(days))
+
+	remainder := floatToDuration(fracYears, yearNanos) +
+		floatToDuration(fracMonths, monthNanos) +
+		floatToDuration(fracWeeks, weekNanos) +
+		floatToDuration(fracDays, dayNanos) +
+		floatToDuration(d.Hours, hourNanos) +
+		floatToDuration(d.Minutes, minuteNanos) +
+		floatToDuration(d.Seconds, secondNanos)
+
+	return t.Add(remainder)
+}
+
+// Between returns the Duration spanning start to end, expressed as whole
+// calendar years, months and days (no weeks) plus a T-part for the
+// remainder, mirroring how AddTo consumes a Duration. If end is before
+// start, the two are swapped, so the result is never negative.
+func Between(start, end time.Time) Duration {
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	years := end.Year() - start.Year()
+	months := int(end.Month()) - int(start.Month())
+	days := end.Day() - start.Day()
+
+	if days < 0 {
+		months--
+		// Borrow days from the month preceding end.
+		prevMonth := time.Date(end.Year(), end.Month(), 0, 0, 0, 0, 0, end.Location())
+		days += prevMonth.Day()
+	}
+	if months < 0 {
+		years--
+		months += 12
+	}
+
+	anchor := start.AddDate(years, months, days)
+	remainder := end.Sub(anchor)
+
+	hours := remainder / time.Hour
+	remainder -= hours * time.Hour
+	minutes := remainder / time.Minute
+	remainder -= minutes * time.Minute
+
+	return Duration{
+		Years:   float64(years),
+		Months:  float64(months),
+		Days:    float64(days),
+		Hours:   float64(hours),
+		Minutes: float64(minutes),
+		Seconds: remainder.Seconds(),
+	}
+}
+
+// CompareAt compares d and other as if both were added to anchor,
+// returning -1, 0 or 1 the same way time.Time.Compare does. Because
+// calendar fields like years and months have no fixed length, a
+// comparison only makes sense relative to a concrete anchor: "P1Y" is
+// longer than "P365D" starting from a leap-year anchor, but not from a
+// non-leap one.
+func (d Duration) CompareAt(anchor time.Time, other Duration) int {
+	a := d.AddTo(anchor)
+	b := other.AddTo(anchor)
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
 func castDuration(value string) (time.Duration, error) {
 	matches, err := parseISODateDuration(value)
 	if err != nil {
 		return 0, err
 	}
-	years := floatToDuration(matches[0], yearNanos)
-	months := floatToDuration(matches[1], monthNanos)
-	weeks := floatToDuration(matches[2], weekNanos)
-	days := floatToDuration(matches[3], dayNanos)
-	hours := floatToDuration(matches[4], hourNanos)
-	minutes := floatToDuration(matches[5], minuteNanos)
-	seconds := floatToDuration(matches[6], secondNanos)
-
-	return years + months + days + weeks + hours + minutes + seconds, nil
+	return newDuration(matches).Total(), nil
 }
 
 func castDurationRegex(value string) (time.Duration, error) {
@@ -181,11 +466,219 @@ func uncastDuration(in interface{}) (string, error) {
 	if !ok {
 		return "", fmt.Errorf("invalid duration - value:%v type:%v", in, reflect.ValueOf(in).Type())
 	}
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
 	y := v / hoursInYear
 	r := v % hoursInYear
 	m := r / hoursInMonth
 	r = r % hoursInMonth
 	d := r / hoursInDay
 	r = r % hoursInDay
-	return strings.ToUpper(fmt.Sprintf("P%dY%dM%dDT%s", y, m, d, r.String())), nil
+	return sign + strings.ToUpper(fmt.Sprintf("P%dY%dM%dDT%s", y, m, d, r.String())), nil
+}
+
+// DurationUnitStyle selects how FormatDurationHuman spells out units.
+type DurationUnitStyle int
+
+const (
+	// DurationUnitShort renders units as single-letter abbreviations, e.g. "2h30m".
+	DurationUnitShort DurationUnitStyle = iota
+	// DurationUnitLong renders units as words, e.g. "2 hours 30 minutes".
+	DurationUnitLong
+)
+
+// HumanOption configures FormatDurationHuman.
+type HumanOption func(*humanOptions)
+
+type humanOptions struct {
+	precision int
+	unitStyle DurationUnitStyle
+	threshold time.Duration
+}
+
+func defaultHumanOptions() humanOptions {
+	return humanOptions{
+		precision: 2,
+		unitStyle: DurationUnitShort,
+		threshold: 7 * hoursInDay,
+	}
+}
+
+// WithPrecision limits FormatDurationHuman's output to the N largest
+// non-zero units, e.g. precision 1 turns "1h30m" into "1h".
+func WithPrecision(n int) HumanOption {
+	return func(o *humanOptions) { o.precision = n }
+}
+
+// WithUnitStyle selects short ("2h30m") or long ("2 hours 30 minutes") unit spelling.
+func WithUnitStyle(style DurationUnitStyle) HumanOption {
+	return func(o *humanOptions) { o.unitStyle = style }
+}
+
+// WithThreshold sets the duration at or above which FormatDurationHuman
+// breaks the output into weeks and days rather than starting at hours.
+func WithThreshold(d time.Duration) HumanOption {
+	return func(o *humanOptions) { o.threshold = d }
+}
+
+type humanUnit struct {
+	size        time.Duration
+	short, long string
+}
+
+// humanUnits is ordered largest to smallest; FormatDurationHuman walks it
+// in this order.
+var humanUnits = []humanUnit{
+	{hoursInWeek, "w", "week"},
+	{hoursInDay, "d", "day"},
+	{time.Hour, "h", "hour"},
+	{time.Minute, "m", "minute"},
+	{time.Second, "s", "second"},
+}
+
+// FormatDurationHuman renders d as a human-friendly string such as
+// "2h30m" or, with WithUnitStyle(DurationUnitLong), "2 hours 30 minutes",
+// for use in reports and CSV exports where ISO 8601 is unwelcoming.
+//
+// By default only hours, minutes and seconds are used; durations at or
+// above WithThreshold (a week, by default) are broken into weeks and
+// days first. WithPrecision caps the number of units emitted.
+func FormatDurationHuman(d time.Duration, opts ...HumanOption) string {
+	o := defaultHumanOptions()
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	start := 2 // Hours.
+	if d >= o.threshold {
+		start = 0 // Weeks.
+	}
+
+	var parts []string
+	remaining := d
+	for i := start; i < len(humanUnits) && len(parts) < o.precision; i++ {
+		u := humanUnits[i]
+		n := remaining / u.size
+		if n == 0 {
+			continue
+		}
+		remaining -= n * u.size
+		parts = append(parts, formatHumanUnit(n, u, o.unitStyle))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, formatHumanUnit(0, humanUnits[len(humanUnits)-1], o.unitStyle))
+	}
+
+	sep := ""
+	if o.unitStyle == DurationUnitLong {
+		sep = " "
+	}
+	return sign + strings.Join(parts, sep)
+}
+
+func formatHumanUnit(n time.Duration, u humanUnit, style DurationUnitStyle) string {
+	if style == DurationUnitShort {
+		return fmt.Sprintf("%d%s", n, u.short)
+	}
+	plural := ""
+	if n != 1 {
+		plural = "s"
+	}
+	return fmt.Sprintf("%d %s%s", n, u.long, plural)
+}
+
+// humanUnitAliases maps the unit spellings castDurationHuman accepts -
+// including abbreviations produced by other tooling - to their nanosecond
+// multiplier.
+var humanUnitAliases = map[string]float64{
+	"y": yearNanos, "yr": yearNanos, "yrs": yearNanos, "year": yearNanos, "years": yearNanos,
+	"mo": monthNanos, "mon": monthNanos, "month": monthNanos, "months": monthNanos,
+	"w": weekNanos, "wk": weekNanos, "wks": weekNanos, "week": weekNanos, "weeks": weekNanos,
+	"d": dayNanos, "day": dayNanos, "days": dayNanos,
+	"h": hourNanos, "hr": hourNanos, "hrs": hourNanos, "hour": hourNanos, "hours": hourNanos,
+	"m": minuteNanos, "min": minuteNanos, "mins": minuteNanos, "minute": minuteNanos, "minutes": minuteNanos,
+	"s": secondNanos, "sec": secondNanos, "secs": secondNanos, "second": secondNanos, "seconds": secondNanos,
+	"ms": float64(time.Millisecond),
+	"us": float64(time.Microsecond),
+	"ns": float64(time.Nanosecond),
+}
+
+// castDurationHuman parses a human-friendly duration such as "2h 30m" or
+// "3 days 4 hours" - a sequence of <number><unit> tokens, optionally
+// space-separated, with an optional leading sign - into a time.Duration.
+// It is the human-format counterpart to castDuration.
+func castDurationHuman(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("error: empty")
+	}
+
+	negative := false
+	if value[0] == '-' || value[0] == '+' {
+		negative = value[0] == '-'
+		value = strings.TrimSpace(value[1:])
+	}
+	if value == "" {
+		return 0, errors.New("error: missing duration after sign")
+	}
+
+	var total time.Duration
+	i := 0
+	parsedAny := false
+	for i < len(value) {
+		for i < len(value) && value[i] == ' ' {
+			i++
+		}
+		if i >= len(value) {
+			break
+		}
+
+		numStart := i
+		for i < len(value) && (value[i] == '.' || (value[i] >= '0' && value[i] <= '9')) {
+			i++
+		}
+		if i == numStart {
+			return 0, fmt.Errorf("error: expected a number, got %q", value[i:])
+		}
+		n, err := strconv.ParseFloat(value[numStart:i], 64)
+		if err != nil {
+			return 0, err
+		}
+
+		for i < len(value) && value[i] == ' ' {
+			i++
+		}
+
+		unitStart := i
+		for i < len(value) && ((value[i] >= 'a' && value[i] <= 'z') || (value[i] >= 'A' && value[i] <= 'Z')) {
+			i++
+		}
+		if i == unitStart {
+			return 0, fmt.Errorf("error: expected a unit, got %q", value[unitStart:])
+		}
+
+		nanos, ok := humanUnitAliases[strings.ToLower(value[unitStart:i])]
+		if !ok {
+			return 0, fmt.Errorf("error: unrecognised duration unit %q", value[unitStart:i])
+		}
+		total += floatToDuration(n, nanos)
+		parsedAny = true
+	}
+	if !parsedAny {
+		return 0, errors.New("error: no duration tokens found")
+	}
+	if negative {
+		total = -total
+	}
+
+	return total, nil
 }
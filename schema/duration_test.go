@@ -1,6 +1,9 @@
 package schema
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -41,17 +44,23 @@ var (
 				floatToDuration(10, dayNanos) +
 				floatToDuration(2, hourNanos) +
 				floatToDuration(30, minuteNanos)},
-		{"Duration with fraction", "P5.1Y3.5M3.4W2.5D",
+		{"Duration with fraction", "P5.1Y3.5M2.5D",
 			floatToDuration(5.1, yearNanos) +
 				floatToDuration(3.5, monthNanos) +
-				floatToDuration(3.4, weekNanos) +
 				floatToDuration(2.5, dayNanos)},
+		{"ZeroTime", "PT0S", 0},
+		{"FractionalYearOnly", "P0.5Y", floatToDuration(0.5, yearNanos)},
 		{"Duration with time with fractions", "P1.5Y2.5M10.5DT2.5H30.5M",
 			floatToDuration(1.5, yearNanos) +
 				floatToDuration(2.5, monthNanos) +
 				floatToDuration(10.5, dayNanos) +
 				floatToDuration(2.5, hourNanos) +
 				floatToDuration(30.5, minuteNanos)},
+		{"NegativeDay", "-P1D", -floatToDuration(1, dayNanos)},
+		{"NegativeMinutes", "-PT30M", -floatToDuration(30, minuteNanos)},
+		{"NegativeYearsMonths", "-P1Y6M",
+			-(floatToDuration(1, yearNanos) + floatToDuration(6, monthNanos))},
+		{"ExplicitPositiveSign", "+P1D", floatToDuration(1, dayNanos)},
 	}
 
 	castDurationErrorData = []struct {
@@ -73,6 +82,11 @@ var (
 		{"P with no units", "P1"},
 		{"P with unit P", "P1P"},
 		{"P with numeric prefix", "1P"},
+		{"WeekMixedWithOtherField", "P1W2D"},
+		{"WeekMixedWithTime", "P1WT1H"},
+		{"TrailingGarbage", "P1Dx"},
+		{"EmptyTimeSection", "PT"},
+		{"MoreThanOneDecimalPoint", "P1.2.3Y"},
 	}
 )
 
@@ -129,6 +143,204 @@ func TestCastDuration_Error(t *testing.T) {
 	}
 }
 
+func TestDuration_String(t *testing.T) {
+	data := []struct {
+		desc string
+		d    Duration
+		want string
+	}{
+		{"Zero", Duration{}, "PT0S"},
+		{"YearsMonthsDays", Duration{Years: 3, Months: 6, Days: 4}, "P3Y6M4D"},
+		{"Weeks", Duration{Weeks: 1.5}, "P1.5W"},
+		{"TimeOnly", Duration{Hours: 12, Minutes: 30, Seconds: 5}, "PT12H30M5S"},
+		{"Complex", Duration{Years: 3, Months: 6, Days: 4, Hours: 12, Minutes: 30, Seconds: 5}, "P3Y6M4DT12H30M5S"},
+		{
+			"Negative",
+			Duration{Days: -1},
+			"-P1D",
+		},
+	}
+	for _, d := range data {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(d.d.String(), d.want)
+		})
+	}
+}
+
+func TestDuration_RoundTrip(t *testing.T) {
+	for _, d := range castDurationSuccessData {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			var dur Duration
+			is.NoErr(dur.UnmarshalText([]byte(d.value)))
+			is.Equal(dur.Total(), d.want)
+
+			marshaled, err := dur.MarshalText()
+			is.NoErr(err)
+
+			var roundTripped Duration
+			is.NoErr(roundTripped.UnmarshalText(marshaled))
+			is.Equal(roundTripped.Total(), dur.Total())
+		})
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	is := is.New(t)
+	dur := Duration{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}
+
+	data, err := json.Marshal(dur)
+	is.NoErr(err)
+	is.Equal(string(data), `"P1Y2M10DT2H30M"`)
+
+	var got Duration
+	is.NoErr(json.Unmarshal(data, &got))
+	is.Equal(got.Total(), dur.Total())
+}
+
+func TestDuration_Gob(t *testing.T) {
+	is := is.New(t)
+	dur := Duration{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}
+
+	var buf bytes.Buffer
+	is.NoErr(gob.NewEncoder(&buf).Encode(dur))
+
+	var got Duration
+	is.NoErr(gob.NewDecoder(&buf).Decode(&got))
+	is.Equal(got.Total(), dur.Total())
+}
+
+func TestDuration_AddTo(t *testing.T) {
+	data := []struct {
+		desc string
+		d    Duration
+		from time.Time
+		want time.Time
+	}{
+		{
+			"OneMonthEndOfMonthOverflows",
+			Duration{Months: 1},
+			time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC), // Go's AddDate normalizes Feb 31 -> Mar 2.
+		},
+		{
+			"OneYearLeapDay",
+			Duration{Years: 1},
+			time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"HoursMinutesSeconds",
+			Duration{Hours: 1, Minutes: 30},
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.January, 1, 1, 30, 0, 0, time.UTC),
+		},
+	}
+	for _, d := range data {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(d.d.AddTo(d.from), d.want)
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	is := is.New(t)
+	start := time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.March, 2, 1, 30, 0, 0, time.UTC)
+
+	d := Between(start, end)
+	is.Equal(d.Years, float64(0))
+	is.Equal(d.Months, float64(1))
+	is.Equal(d.Hours, float64(1))
+	is.Equal(d.Minutes, float64(30))
+
+	// AddTo should recover the end time from start.
+	is.Equal(d.AddTo(start), end)
+}
+
+func TestDuration_CompareAt(t *testing.T) {
+	is := is.New(t)
+	leapAnchor := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	oneYear := Duration{Years: 1}
+	days366 := Duration{Days: 366}
+	days365 := Duration{Days: 365}
+
+	is.Equal(oneYear.CompareAt(leapAnchor, days366), 0)
+	is.Equal(oneYear.CompareAt(leapAnchor, days365), 1)
+
+	nonLeapAnchor := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	is.Equal(oneYear.CompareAt(nonLeapAnchor, days365), 0)
+}
+
+func TestFormatDurationHuman(t *testing.T) {
+	data := []struct {
+		desc string
+		d    time.Duration
+		opts []HumanOption
+		want string
+	}{
+		{"HoursMinutesShort", 2*time.Hour + 30*time.Minute, nil, "2h30m"},
+		{"HoursMinutesLong", 2*time.Hour + 30*time.Minute, []HumanOption{WithUnitStyle(DurationUnitLong)}, "2 hours 30 minutes"},
+		{"Negative", -90 * time.Minute, nil, "-1h30m"},
+		{"PrecisionOne", 2*time.Hour + 30*time.Minute + 5*time.Second, []HumanOption{WithPrecision(1)}, "2h"},
+		{"Zero", 0, nil, "0s"},
+		{"AboveThresholdUsesWeeksDays", 10 * hoursInDay, nil, "1w3d"},
+		{"BelowThresholdStaysInHours", 6 * hoursInDay, nil, "144h"},
+		{"CustomThreshold", 2 * hoursInDay, []HumanOption{WithThreshold(hoursInDay), WithPrecision(3)}, "2d"},
+	}
+	for _, d := range data {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			is.Equal(FormatDurationHuman(d.d, d.opts...), d.want)
+		})
+	}
+}
+
+var castDurationHumanSuccessData = []struct {
+	desc  string
+	value string
+	want  time.Duration
+}{
+	{"ShortHoursMinutes", "2h30m", 2*time.Hour + 30*time.Minute},
+	{"SpacedLong", "3 days 4 hours", 3*hoursInDay + 4*time.Hour},
+	{"YearsAndMonths", "1yr 6mo", time.Duration(1*yearNanos) + time.Duration(6*monthNanos)},
+	{"MillisecondsAlias", "500ms", 500 * time.Millisecond},
+	{"NegativeSign", "-30min", -30 * time.Minute},
+}
+
+func TestCastDurationHuman_Success(t *testing.T) {
+	for _, d := range castDurationHumanSuccessData {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			got, err := castDurationHuman(d.value)
+			is.NoErr(err)
+			is.Equal(got, d.want)
+		})
+	}
+}
+
+func TestCastDurationHuman_Error(t *testing.T) {
+	data := []struct {
+		desc  string
+		value string
+	}{
+		{"Empty", ""},
+		{"MissingUnit", "30"},
+		{"MissingNumber", "h"},
+		{"UnknownUnit", "5fortnights"},
+		{"SignOnly", "-"},
+	}
+	for _, d := range data {
+		t.Run(d.desc, func(t *testing.T) {
+			is := is.New(t)
+			_, err := castDurationHuman(d.value)
+			is.True(err != nil)
+		})
+	}
+}
+
 func TestUncastDuration(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		data := []struct {
@@ -137,6 +349,7 @@ func TestUncastDuration(t *testing.T) {
 			want  string
 		}{
 			{"1Year", 1*hoursInYear + 1*hoursInMonth + 1*hoursInDay + 1*time.Hour + 1*time.Minute + 500*time.Millisecond, "P1Y1M1DT1H1M0.5S"},
+			{"Negative", -(1*hoursInYear + 1*time.Hour + 1*time.Minute), "-P1Y0M0DT1H1M0S"},
 		}
 		for _, d := range data {
 			t.Run(d.desc, func(t *testing.T) {